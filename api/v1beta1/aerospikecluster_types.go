@@ -0,0 +1,49 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// AerospikeClusterSpec is the spec of the AerospikeCluster CR. Only the
+// fields controllers/aero_info_calls.go and its neighbours read are
+// declared here; the rest of the real spec (Size, Image, Storage, ...)
+// lives outside this change series.
+type AerospikeClusterSpec struct {
+	// AerospikeConfig is the `aerospike.conf` equivalent configuration,
+	// used to derive heartbeat/service ports and TLS names for info calls.
+	// +optional
+	AerospikeConfig *AerospikeConfigSpec `json:"aerospikeConfig,omitempty"`
+
+	// Operations tunes bulk, fan-out operations the operator performs
+	// against cluster pods (info-call concurrency, stability-poll backoff).
+	// +optional
+	Operations *OperationsSpec `json:"operations,omitempty"`
+
+	// RackConfig groups rack-awareness and rolling-operation settings.
+	// +optional
+	RackConfig RackConfig `json:"rackConfig,omitempty"`
+}
+
+// AerospikeConfigSpec is a placeholder for the real `aerospike.conf`-shaped
+// configuration type; only its existence as a field type is needed here.
+type AerospikeConfigSpec struct{}
+
+// RackConfig groups rack-awareness and rolling-operation settings. Only
+// SafeStopPolicy is declared here; the rest of the real type (Racks,
+// Namespaces, RollingUpdateBatchSize, ...) lives outside this change series.
+type RackConfig struct {
+	// SafeStopPolicy composes the predicates that must pass before a node in
+	// this cluster may be stopped/quiesced, beyond the implicit
+	// cluster-stable check.
+	// +optional
+	SafeStopPolicy *SafeStopPolicySpec `json:"safeStopPolicy,omitempty"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperationsSpec tunes how the operator performs bulk, fan-out operations
+// against cluster pods, such as info calls issued during rolling restarts
+// and cluster stability checks. It is added as the `operations` field on
+// AerospikeClusterSpec.
+type OperationsSpec struct {
+	// Concurrency is the maximum number of pods the operator will probe in
+	// parallel when fanning out info commands (host connections, readiness
+	// checks, cluster-stability polls). A value <= 0 means the operator
+	// picks a sane default.
+	// +optional
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// RetryBackoff configures the exponential backoff (with jitter) used
+	// while polling for cluster stability, replacing the previous fixed
+	// 10-second sleep.
+	// +optional
+	RetryBackoff *RetryBackoffSpec `json:"retryBackoff,omitempty"`
+}
+
+// RetryBackoffSpec describes an exponential backoff with jitter.
+type RetryBackoffSpec struct {
+	// BaseDelay is the delay before the first retry.
+	// +optional
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+
+	// MaxDelay caps the computed delay regardless of attempt count.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// MaxRetries bounds the number of stability-poll attempts. A value <= 0
+	// keeps the default of 12.
+	// +optional
+	MaxRetries int `json:"maxRetries,omitempty"`
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// SafeStopPolicySpec composes the predicates the operator must satisfy
+// before it is allowed to stop/quiesce a node, on top of the existing
+// implicit "cluster stable + quiesce" check. It is added as the
+// `safeStopPolicy` field on RackConfig, modeled loosely on how a
+// PodDisruptionBudget admission check composes availability gates.
+// +optional
+type SafeStopPolicySpec struct {
+	// MinAvailablePerNamespace requires at least this many roster nodes to
+	// remain available, per SC namespace, after the stop.
+	// +optional
+	MinAvailablePerNamespace *int32 `json:"minAvailablePerNamespace,omitempty"`
+
+	// MaxUnavailableFraction caps the fraction (0.0-1.0) of roster nodes
+	// that may be unavailable at once, per SC namespace.
+	// +optional
+	MaxUnavailableFraction *string `json:"maxUnavailableFraction,omitempty"`
+
+	// NoActiveMigrations, when true, blocks the stop while any namespace has
+	// in-flight migrations.
+	// +optional
+	NoActiveMigrations bool `json:"noActiveMigrations,omitempty"`
+
+	// AllRostersConverged, when true, requires the observed roster of the SC
+	// namespace the node is quiesced through to match its configured roster
+	// before allowing the stop. Other namespaces are not checked.
+	// +optional
+	AllRostersConverged bool `json:"allRostersConverged,omitempty"`
+
+	// TimeWindow restricts safe-stop evaluation to pass only within this
+	// window (e.g. a maintenance window), expressed as "start-end" in 24h
+	// "HH:MM" form, UTC.
+	// +optional
+	TimeWindow *TimeWindowSpec `json:"timeWindow,omitempty"`
+}
+
+// TimeWindowSpec is a daily UTC time-of-day window, "HH:MM"-"HH:MM".
+type TimeWindowSpec struct {
+	// Start is the window's opening time, "HH:MM", UTC.
+	Start string `json:"start"`
+
+	// End is the window's closing time, "HH:MM", UTC.
+	End string `json:"end"`
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -21,7 +22,6 @@ import (
 	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
 	"github.com/aerospike/aerospike-kubernetes-operator/pkg/utils"
 	"github.com/aerospike/aerospike-management-lib/deployment"
-	as "github.com/ashishshinde/aerospike-client-go/v5"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -43,9 +43,9 @@ func (r *SingleClusterReconciler) waitForNodeSafeStopReady(
 		return reconcileError(err)
 	}
 
-	policy := r.getClientPolicy()
+	ic := r.infoClient()
 
-	namespaces, err := r.getNamespaces(policy, hostConn)
+	namespaces, err := ic.GetNamespaces(hostConn)
 	if err != nil {
 		return reconcileError(err)
 	}
@@ -55,24 +55,24 @@ func (r *SingleClusterReconciler) waitForNodeSafeStopReady(
 	// Find non-sc namespace or a sc namespace for which node is part of roster
 	// and use that namespace to quiesce the node
 	for _, ns := range namespaces {
-		isEnabled, err := r.isNamespaceSCEnabled(policy, hostConn, ns)
+		isEnabled, err := ic.IsNamespaceSCEnabled(hostConn, ns)
 		if err != nil {
 			return reconcileError(err)
 		}
 
 		if !isEnabled {
 			r.Log.Info("Namespace is not sc enabled, do quiesce using this namespace", "namespace", ns)
-			return r.waitForNodeSafeStopReadyAndQuiesce(policy, pod, ignorablePods, ns)
+			return r.waitForNodeSafeStopReadyAndQuiesce(pod, ignorablePods, ns)
 		}
 
-		isInRoster, err := r.isNodeInRoster(policy, hostConn, ns)
+		isInRoster, err := ic.IsNodeInRoster(hostConn, ns)
 		if err != nil {
 			return reconcileError(err)
 		}
 
 		if isInRoster {
 			r.Log.Info("Namespace is sc enabled and in roster, do quiesce using this namespce", "namespace", ns)
-			return r.waitForNodeSafeStopReadyAndQuiesce(policy, pod, ignorablePods, ns)
+			return r.waitForNodeSafeStopReadyAndQuiesce(pod, ignorablePods, ns)
 		}
 
 		r.Log.Info("Node is not in roster for namespace. Skip quiesce", "node", pod.Name, "namespace", ns)
@@ -87,7 +87,7 @@ func (r *SingleClusterReconciler) waitForNodeSafeStopReady(
 // skipping pods that are not running and present in ignorablePods for stability check.
 // The ignorablePods list should be a list of failed or pending pods that are going to be
 // deleted eventually and are safe to ignore in stability checks.
-func (r *SingleClusterReconciler) waitForNodeSafeStopReadyAndQuiesce(policy *as.ClientPolicy, pod *corev1.Pod, ignorablePods []corev1.Pod, ns string) reconcileResult {
+func (r *SingleClusterReconciler) waitForNodeSafeStopReadyAndQuiesce(pod *corev1.Pod, ignorablePods []corev1.Pod, ns string) reconcileResult {
 
 	// Remove a node only if cluster is stable
 	err := r.waitForAllSTSToBeReady()
@@ -109,7 +109,7 @@ func (r *SingleClusterReconciler) waitForNodeSafeStopReadyAndQuiesce(policy *as.
 	}
 
 	// Check for cluster stability
-	if res := r.waitForClusterStability(policy, allHostConns); !res.isSuccess {
+	if res := r.waitForClusterStability(allHostConns); !res.isSuccess {
 		return res
 	}
 
@@ -119,39 +119,114 @@ func (r *SingleClusterReconciler) waitForNodeSafeStopReadyAndQuiesce(policy *as.
 		return reconcileError(err)
 	}
 
-	if err := deployment.InfoQuiesce(
-		r.Log, policy, allHostConns, selectedHostConn, ns,
-	); err != nil {
+	if res := r.evaluateSafeStopPolicy(pod, ns, allHostConns); !res.isSuccess {
+		return res
+	}
+
+	if err := r.infoClient().Quiesce(allHostConns, selectedHostConn, ns); err != nil {
 		return reconcileError(err)
 	}
 
 	return reconcileSuccess()
 }
 
+// evaluateSafeStopPolicy runs spec.rackConfig.safeStopPolicy's predicates,
+// beyond the implicit cluster-stable check, before the node is quiesced. A
+// denying predicate's reason is recorded as a Kubernetes event on the
+// cluster CR and the reconcile is requeued after its suggested delay.
+func (r *SingleClusterReconciler) evaluateSafeStopPolicy(
+	pod *corev1.Pod, ns string, allHostConns []*deployment.HostConn,
+) reconcileResult {
+	policy := buildSafeStopPolicy(r.aeroCluster.Spec.RackConfig.SafeStopPolicy)
+	if len(policy.Predicates) == 0 {
+		return reconcileSuccess()
+	}
+
+	if len(allHostConns) == 0 {
+		return reconcileError(fmt.Errorf("no hostConn available to evaluate safe-stop policy for namespace %s", ns))
+	}
+
+	ic := r.infoClient()
+
+	isStable, err := ic.IsClusterStable(allHostConns)
+	if err != nil {
+		return reconcileError(err)
+	}
+
+	state := ClusterState{
+		Namespace:           ns,
+		HasActiveMigrations: !isStable,
+	}
+
+	// roster: is only meaningful for SC-enabled namespaces; asking for it on
+	// a non-SC namespace would needlessly fail the stop.
+	isSCEnabled, err := ic.IsNamespaceSCEnabled(allHostConns[0], ns)
+	if err != nil {
+		return reconcileError(err)
+	}
+
+	if isSCEnabled {
+		roster, err := ic.GetRosterInfo(allHostConns[0], ns)
+		if err != nil {
+			return reconcileError(err)
+		}
+
+		state.RosterSize = len(roster.Roster)
+		state.AvailableRosterNodes = countObservedRosterNodes(roster)
+		state.RosterConverged = isRosterConverged(roster)
+	}
+
+	allowed, reason, retryAfter := policy.Evaluate(context.TODO(), state)
+	if allowed {
+		return reconcileSuccess()
+	}
+
+	r.Log.Info("Safe-stop policy denied node stop", "node", pod.Name, "namespace", ns, "reason", reason)
+
+	if r.Recorder != nil {
+		r.Recorder.Eventf(
+			r.aeroCluster, corev1.EventTypeWarning, "SafeStopDenied",
+			"node %s: %s", pod.Name, reason,
+		)
+	}
+
+	return reconcileRequeueAfter(int(retryAfter.Seconds()))
+}
+
 // TODO: Check only for migration
-func (r *SingleClusterReconciler) waitForClusterStability(policy *as.ClientPolicy, allHostConns []*deployment.HostConn) reconcileResult {
+func (r *SingleClusterReconciler) waitForClusterStability(allHostConns []*deployment.HostConn) reconcileResult {
 	const maxRetry = 12
-	const retryInterval = time.Second * 10
+
+	baseDelay, maxDelay := r.stabilityBackoffConfig()
+
+	retries := maxRetry
+	if ops := r.aeroCluster.Spec.Operations; ops != nil && ops.RetryBackoff != nil && ops.RetryBackoff.MaxRetries > 0 {
+		retries = ops.RetryBackoff.MaxRetries
+	}
 
 	var isStable bool
-	// Wait for migration to finish. Wait for some time...
-	for idx := 1; idx <= maxRetry; idx++ {
-		r.Log.V(1).Info("Waiting for migrations to be zero")
-		time.Sleep(retryInterval)
+
+	// Wait for migration to finish, backing off between rounds. Each round is
+	// one IsClusterStable call covering all hosts; this loop does not split
+	// that check across goroutines.
+	for attempt := 1; attempt <= retries; attempt++ {
+		delay := backoffWithJitter(attempt, baseDelay, maxDelay)
+		r.Log.V(1).Info("Waiting for migrations to be zero", "attempt", attempt, "delay", delay)
+		time.Sleep(delay)
 
 		// This should fail if coldstart is going on.
 		// Info command in coldstarting node should give error, is it? confirm.
-
-		isStable, err := deployment.IsClusterAndStable(
-			r.Log, r.getClientPolicy(), allHostConns,
-		)
+		stable, err := r.infoClient().IsClusterStable(allHostConns)
 		if err != nil {
 			return reconcileError(err)
 		}
+
+		isStable = stable
 		if isStable {
 			break
 		}
 	}
+
 	if !isStable {
 		return reconcileRequeueAfter(60)
 	}
@@ -159,6 +234,42 @@ func (r *SingleClusterReconciler) waitForClusterStability(policy *as.ClientPolic
 	return reconcileSuccess()
 }
 
+// stabilityBackoffConfig returns the base/max backoff delays to use while
+// polling for cluster stability, honouring spec.operations.retryBackoff when
+// set and falling back to sane defaults otherwise.
+func (r *SingleClusterReconciler) stabilityBackoffConfig() (time.Duration, time.Duration) {
+	baseDelay := defaultBackoffBaseDelay
+	maxDelay := defaultBackoffMaxDelay
+
+	operations := r.aeroCluster.Spec.Operations
+	if operations == nil {
+		return baseDelay, maxDelay
+	}
+
+	if backoff := operations.RetryBackoff; backoff != nil {
+		if backoff.BaseDelay != nil {
+			baseDelay = backoff.BaseDelay.Duration
+		}
+
+		if backoff.MaxDelay != nil {
+			maxDelay = backoff.MaxDelay.Duration
+		}
+	}
+
+	return baseDelay, maxDelay
+}
+
+// operationConcurrency returns the configured fan-out concurrency for info
+// calls, defaulting when spec.operations.concurrency is unset.
+func (r *SingleClusterReconciler) operationConcurrency() int {
+	operations := r.aeroCluster.Spec.Operations
+	if operations == nil || operations.Concurrency <= 0 {
+		return defaultOperationConcurrency
+	}
+
+	return operations.Concurrency
+}
+
 func (r *SingleClusterReconciler) tipClearHostname(
 	pod *corev1.Pod, clearPodName string,
 ) error {
@@ -248,10 +359,14 @@ func (r *SingleClusterReconciler) newAllHostConnWithOption(ignorablePods []corev
 		return nil, fmt.Errorf("pod list empty")
 	}
 
-	var hostConns []*deployment.HostConn
-	for _, pod := range podList.Items {
+	// Readiness checks and newHostConn's per-pod lookups (IP, TLS name/port)
+	// are both worth fanning out so a rolling restart over dozens of nodes
+	// doesn't serialize them one pod at a time.
+	readyPods := make([]*corev1.Pod, len(podList.Items))
+
+	err = runPooled(r.operationConcurrency(), podList.Items, func(pod corev1.Pod) error {
 		if utils.IsPodTerminating(&pod) {
-			continue
+			return nil
 		}
 
 		// Checking if all the container in the pod are ready or not
@@ -262,21 +377,59 @@ func (r *SingleClusterReconciler) newAllHostConnWithOption(ignorablePods []corev
 				r.Log.Info(
 					"Ignoring info call on non-running pod ", "pod", pod.Name,
 				)
-				continue
+				return nil
 			}
-			return nil, fmt.Errorf("pod %v is not ready", pod.Name)
+			return fmt.Errorf("pod %v is not ready", pod.Name)
 		}
 
-		hostConn, err := r.newHostConn(&pod)
-		if err != nil {
-			return nil, err
+		readyPods[indexOfPod(podList.Items, pod)] = &pod
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eligiblePods := make([]corev1.Pod, 0, len(readyPods))
+
+	for _, pod := range readyPods {
+		if pod != nil {
+			eligiblePods = append(eligiblePods, *pod)
 		}
-		hostConns = append(hostConns, hostConn)
+	}
+
+	hostConns := make([]*deployment.HostConn, len(eligiblePods))
+
+	err = runPooled(r.operationConcurrency(), eligiblePods, func(pod corev1.Pod) error {
+		hostConn, hcErr := r.newHostConn(&pod)
+		if hcErr != nil {
+			return hcErr
+		}
+
+		hostConns[indexOfPod(eligiblePods, pod)] = hostConn
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return hostConns, nil
 }
 
+// indexOfPod finds pod's position in pods by name. Pod names within a
+// cluster pod list are unique, so this is sufficient to let pooled workers
+// write their result back to a stable slot.
+func indexOfPod(pods []corev1.Pod, pod corev1.Pod) int {
+	for i := range pods {
+		if pods[i].Name == pod.Name {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func (r *SingleClusterReconciler) newHostConn(pod *corev1.Pod) (
 	*deployment.HostConn, error,
 ) {
@@ -310,6 +463,9 @@ func (r *SingleClusterReconciler) newAsConn(pod *corev1.Pod) (
 
 // ParseInfoIntoMap parses info string into a map.
 // TODO adapted from management lib. Should be made public there.
+// Prefer pkg/infoparse.Decode for commands that have a registered typed
+// decoder; this is kept as a fallback for commands infoparse doesn't know
+// about yet.
 func ParseInfoIntoMap(str string, del string, sep string) (map[string]string, error) {
 	m := map[string]string{}
 	if str == "" {
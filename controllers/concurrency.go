@@ -0,0 +1,148 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultOperationConcurrency is used when the CR does not set
+	// spec.operations.concurrency.
+	defaultOperationConcurrency = 8
+
+	defaultBackoffBaseDelay = 2 * time.Second
+	defaultBackoffMaxDelay  = 30 * time.Second
+)
+
+// multiError aggregates the errors produced by a pool of workers that each
+// operate on one item, preserving every failure instead of returning only
+// the first one seen.
+type multiError struct {
+	errs []error
+}
+
+func (m *multiError) Error() string {
+	msgs := make([]string, 0, len(m.errs))
+	for _, err := range m.errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("%d error(s) occurred: %s", len(m.errs), strings.Join(msgs, "; "))
+}
+
+// Unwrap lets errors.Is/errors.As reach any of the aggregated errors.
+func (m *multiError) Unwrap() []error {
+	return m.errs
+}
+
+// asMultiError returns nil if errs has no non-nil entries, the single error
+// if there is exactly one, and a *multiError otherwise.
+func asMultiError(errs []error) error {
+	nonNil := make([]error, 0, len(errs))
+
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	default:
+		return &multiError{errs: nonNil}
+	}
+}
+
+// runPooled runs fn(item) for every item in items using at most concurrency
+// goroutines reading off a shared work queue (the same fan-out-workers-off-
+// a-queue shape used by the Kubernetes endpoint controller), and returns the
+// aggregated errors from every failing item.
+func runPooled[T any](concurrency int, items []T, fn func(item T) error) error {
+	if concurrency <= 0 {
+		concurrency = defaultOperationConcurrency
+	}
+
+	if concurrency > len(items) {
+		concurrency = len(items)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	workCh := make(chan T, len(items))
+	for _, item := range items {
+		workCh <- item
+	}
+	close(workCh)
+
+	errs := make([]error, len(items))
+	var idx int32
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	wg.Add(concurrency)
+
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+
+			for item := range workCh {
+				err := fn(item)
+				if err == nil {
+					continue
+				}
+
+				mu.Lock()
+				errs[idx] = err
+				idx++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return asMultiError(errs[:idx])
+}
+
+// backoffWithJitter returns the delay to use before retry attempt (1-indexed),
+// growing exponentially from base and capped at max, with up to 20% jitter
+// added to avoid every caller retrying in lockstep.
+func backoffWithJitter(attempt int, base, max time.Duration) time.Duration {
+	if base <= 0 {
+		base = defaultBackoffBaseDelay
+	}
+
+	if max <= 0 {
+		max = defaultBackoffMaxDelay
+	}
+
+	delay := base << uint(attempt-1)
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1))
+
+	return delay + jitter
+}
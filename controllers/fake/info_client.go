@@ -0,0 +1,188 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory controllers.InfoClient for driving
+// table-driven tests over reconciler logic (quiesce paths, roster/SC
+// branches) without a live Aerospike cluster.
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aerospike/aerospike-kubernetes-operator/controllers"
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/infoparse"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NamespaceState is the per-namespace state InfoClient reports for a pod.
+type NamespaceState struct {
+	SCEnabled bool
+	InRoster  bool
+}
+
+// InfoClient is an in-memory controllers.InfoClient. Tests drive cluster
+// state by mutating its exported fields/maps directly before invoking
+// reconciler logic.
+type InfoClient struct {
+	mu sync.Mutex
+
+	// Namespaces is keyed by namespace name.
+	Namespaces map[string]NamespaceState
+
+	// Rosters is keyed by namespace name and backs GetRosterInfo.
+	Rosters map[string]infoparse.RosterInfo
+
+	// ClusterStable is returned by IsClusterStable.
+	ClusterStable bool
+
+	// Quiesced, TippedHostnames, TipClearedHostnames and AlumniResetPods
+	// record calls made through this client for assertions.
+	Quiesced            []string
+	TippedHostnames     []string
+	TipClearedHostnames []string
+	AlumniResetPods     []string
+
+	// Err, when set, is returned by every method instead of a real result.
+	Err error
+}
+
+// New returns an InfoClient with no namespaces and ClusterStable true.
+func New() *InfoClient {
+	return &InfoClient{
+		Namespaces:    map[string]NamespaceState{},
+		Rosters:       map[string]infoparse.RosterInfo{},
+		ClusterStable: true,
+	}
+}
+
+var _ controllers.InfoClient = (*InfoClient)(nil)
+
+func (f *InfoClient) Quiesce(_ []*deployment.HostConn, _ *deployment.HostConn, ns string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return f.Err
+	}
+
+	f.Quiesced = append(f.Quiesced, ns)
+
+	return nil
+}
+
+func (f *InfoClient) TipHostname(_, clearPod *corev1.Pod) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return f.Err
+	}
+
+	f.TippedHostnames = append(f.TippedHostnames, clearPod.Name)
+
+	return nil
+}
+
+func (f *InfoClient) TipClearHostname(_ *corev1.Pod, clearPodName string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return f.Err
+	}
+
+	f.TipClearedHostnames = append(f.TipClearedHostnames, clearPodName)
+
+	return nil
+}
+
+func (f *InfoClient) AlumniReset(pod *corev1.Pod) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return f.Err
+	}
+
+	f.AlumniResetPods = append(f.AlumniResetPods, pod.Name)
+
+	return nil
+}
+
+func (f *InfoClient) IsClusterStable(_ []*deployment.HostConn) (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+
+	return f.ClusterStable, nil
+}
+
+func (f *InfoClient) GetNamespaces(_ *deployment.HostConn) ([]string, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	namespaces := make([]string, 0, len(f.Namespaces))
+	for ns := range f.Namespaces {
+		namespaces = append(namespaces, ns)
+	}
+
+	// waitForNodeSafeStopReady returns on the first namespace match, so an
+	// unsorted, map-iteration-order result would make tests driven by this
+	// fake flaky.
+	sort.Strings(namespaces)
+
+	return namespaces, nil
+}
+
+func (f *InfoClient) IsNamespaceSCEnabled(_ *deployment.HostConn, ns string) (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+
+	state, ok := f.Namespaces[ns]
+	if !ok {
+		return false, fmt.Errorf("fake: unknown namespace %q", ns)
+	}
+
+	return state.SCEnabled, nil
+}
+
+func (f *InfoClient) IsNodeInRoster(_ *deployment.HostConn, ns string) (bool, error) {
+	if f.Err != nil {
+		return false, f.Err
+	}
+
+	state, ok := f.Namespaces[ns]
+	if !ok {
+		return false, fmt.Errorf("fake: unknown namespace %q", ns)
+	}
+
+	return state.InRoster, nil
+}
+
+func (f *InfoClient) GetRosterInfo(_ *deployment.HostConn, ns string) (infoparse.RosterInfo, error) {
+	if f.Err != nil {
+		return infoparse.RosterInfo{}, f.Err
+	}
+
+	roster, ok := f.Rosters[ns]
+	if !ok {
+		return infoparse.RosterInfo{}, fmt.Errorf("fake: unknown namespace %q", ns)
+	}
+
+	return roster, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/infoparse"
+)
+
+func TestGetNamespacesIsSorted(t *testing.T) {
+	client := New()
+	client.Namespaces = map[string]NamespaceState{
+		"zzz": {},
+		"aaa": {},
+		"mmm": {},
+	}
+
+	got, err := client.GetNamespaces(nil)
+	if err != nil {
+		t.Fatalf("GetNamespaces: unexpected error: %v", err)
+	}
+
+	want := []string{"aaa", "mmm", "zzz"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetNamespaces() = %v, want %v (repeated calls must return a deterministic order)", got, want)
+	}
+}
+
+func TestIsNamespaceSCEnabledAndInRoster(t *testing.T) {
+	client := New()
+	client.Namespaces = map[string]NamespaceState{
+		"test": {SCEnabled: true, InRoster: false},
+	}
+
+	scEnabled, err := client.IsNamespaceSCEnabled(nil, "test")
+	if err != nil {
+		t.Fatalf("IsNamespaceSCEnabled: unexpected error: %v", err)
+	}
+
+	if !scEnabled {
+		t.Error("IsNamespaceSCEnabled = false, want true")
+	}
+
+	inRoster, err := client.IsNodeInRoster(nil, "test")
+	if err != nil {
+		t.Fatalf("IsNodeInRoster: unexpected error: %v", err)
+	}
+
+	if inRoster {
+		t.Error("IsNodeInRoster = true, want false")
+	}
+
+	if _, err := client.IsNamespaceSCEnabled(nil, "unknown"); err == nil {
+		t.Error("IsNamespaceSCEnabled(unknown namespace): expected error, got none")
+	}
+}
+
+func TestGetRosterInfo(t *testing.T) {
+	client := New()
+	client.Rosters["test"] = infoparse.RosterInfo{
+		Namespace: "test",
+		Roster:    []string{"A", "B"},
+	}
+
+	got, err := client.GetRosterInfo(nil, "test")
+	if err != nil {
+		t.Fatalf("GetRosterInfo: unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.Roster, []string{"A", "B"}) {
+		t.Errorf("Roster = %v, want [A B]", got.Roster)
+	}
+
+	if _, err := client.GetRosterInfo(nil, "unknown"); err == nil {
+		t.Error("GetRosterInfo(unknown namespace): expected error, got none")
+	}
+}
+
+func TestQuiesceRecordsCallsAndHonoursErr(t *testing.T) {
+	client := New()
+
+	if err := client.Quiesce(nil, nil, "test"); err != nil {
+		t.Fatalf("Quiesce: unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(client.Quiesced, []string{"test"}) {
+		t.Errorf("Quiesced = %v, want [test]", client.Quiesced)
+	}
+
+	client.Err = errors.New("injected failure")
+	if err := client.Quiesce(nil, nil, "test2"); err != client.Err {
+		t.Errorf("Quiesce with Err set = %v, want %v", err, client.Err)
+	}
+
+	// The failed call must not have been recorded.
+	if !reflect.DeepEqual(client.Quiesced, []string{"test"}) {
+		t.Errorf("Quiesced after failed call = %v, want [test]", client.Quiesced)
+	}
+}
+
+func TestIsClusterStable(t *testing.T) {
+	client := New()
+
+	stable, err := client.IsClusterStable(nil)
+	if err != nil {
+		t.Fatalf("IsClusterStable: unexpected error: %v", err)
+	}
+
+	if !stable {
+		t.Error("IsClusterStable() = false, want true (New() defaults ClusterStable to true)")
+	}
+
+	client.ClusterStable = false
+
+	stable, err = client.IsClusterStable(nil)
+	if err != nil {
+		t.Fatalf("IsClusterStable: unexpected error: %v", err)
+	}
+
+	if stable {
+		t.Error("IsClusterStable() = true, want false")
+	}
+}
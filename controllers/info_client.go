@@ -0,0 +1,106 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/aerospike/aerospike-kubernetes-operator/pkg/infoparse"
+	"github.com/aerospike/aerospike-management-lib/deployment"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InfoClient is the seam between the reconciler and the Aerospike info
+// protocol. It exists so reconciler logic (quiesce paths, tipHostname,
+// alumniReset, and the roster/SC branches in waitForNodeSafeStopReady) can
+// be unit tested without a live cluster by swapping in fake.InfoClient.
+type InfoClient interface {
+	Quiesce(allHostConns []*deployment.HostConn, selectedHostConn *deployment.HostConn, ns string) error
+	TipHostname(pod, clearPod *corev1.Pod) error
+	TipClearHostname(pod *corev1.Pod, clearPodName string) error
+	AlumniReset(pod *corev1.Pod) error
+	IsClusterStable(allHostConns []*deployment.HostConn) (bool, error)
+	GetNamespaces(hostConn *deployment.HostConn) ([]string, error)
+	IsNamespaceSCEnabled(hostConn *deployment.HostConn, ns string) (bool, error)
+	IsNodeInRoster(hostConn *deployment.HostConn, ns string) (bool, error)
+	// GetRosterInfo returns the decoded "roster:" state for ns, used by the
+	// SafeStopPolicy's AllRostersConverged predicate.
+	GetRosterInfo(hostConn *deployment.HostConn, ns string) (infoparse.RosterInfo, error)
+}
+
+// InfoClientFactory builds the InfoClient a reconciler should use. It is a
+// field on SingleClusterReconciler so tests (and users wiring in an
+// alternative transport, e.g. a sidecar proxy) can override the default
+// management-lib-backed implementation.
+type InfoClientFactory func(r *SingleClusterReconciler) InfoClient
+
+// infoClient returns r.InfoClientFactory's client, defaulting to the
+// management-lib-backed implementation when no factory is set.
+func (r *SingleClusterReconciler) infoClient() InfoClient {
+	if r.InfoClientFactory != nil {
+		return r.InfoClientFactory(r)
+	}
+
+	return &managementLibInfoClient{r: r}
+}
+
+// managementLibInfoClient is the default InfoClient, backed by
+// aerospike-management-lib's deployment package and the raw info commands
+// already used throughout this package.
+type managementLibInfoClient struct {
+	r *SingleClusterReconciler
+}
+
+func (c *managementLibInfoClient) Quiesce(
+	allHostConns []*deployment.HostConn, selectedHostConn *deployment.HostConn, ns string,
+) error {
+	return deployment.InfoQuiesce(
+		c.r.Log, c.r.getClientPolicy(), allHostConns, selectedHostConn, ns,
+	)
+}
+
+func (c *managementLibInfoClient) TipHostname(pod, clearPod *corev1.Pod) error {
+	return c.r.tipHostname(pod, clearPod)
+}
+
+func (c *managementLibInfoClient) TipClearHostname(pod *corev1.Pod, clearPodName string) error {
+	return c.r.tipClearHostname(pod, clearPodName)
+}
+
+func (c *managementLibInfoClient) AlumniReset(pod *corev1.Pod) error {
+	return c.r.alumniReset(pod)
+}
+
+func (c *managementLibInfoClient) IsClusterStable(allHostConns []*deployment.HostConn) (bool, error) {
+	return deployment.IsClusterAndStable(c.r.Log, c.r.getClientPolicy(), allHostConns)
+}
+
+func (c *managementLibInfoClient) GetNamespaces(hostConn *deployment.HostConn) ([]string, error) {
+	return c.r.getNamespaces(c.r.getClientPolicy(), hostConn)
+}
+
+func (c *managementLibInfoClient) IsNamespaceSCEnabled(hostConn *deployment.HostConn, ns string) (bool, error) {
+	return c.r.isNamespaceSCEnabled(c.r.getClientPolicy(), hostConn, ns)
+}
+
+func (c *managementLibInfoClient) IsNodeInRoster(hostConn *deployment.HostConn, ns string) (bool, error) {
+	return c.r.isNodeInRoster(c.r.getClientPolicy(), hostConn, ns)
+}
+
+func (c *managementLibInfoClient) GetRosterInfo(hostConn *deployment.HostConn, ns string) (infoparse.RosterInfo, error) {
+	raw, err := c.r.getRosterInfo(c.r.getClientPolicy(), hostConn, ns)
+	if err != nil {
+		return infoparse.RosterInfo{}, err
+	}
+
+	return infoparse.Decode[infoparse.RosterInfo]("roster:", raw)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aerospike/aerospike-management-lib/deployment"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// rosterWatcherPollInterval is how often a rosterWatcher polls
+// "roster:"/"namespaces" info commands looking for out-of-band changes.
+const rosterWatcherPollInterval = 30 * time.Second
+
+// rosterWatcher polls an AerospikeCluster's namespace and roster state on an
+// interval and signals the main reconciler, via a source.Channel event, when
+// it detects a change made outside of a reconcile pass (e.g. an operator
+// manually running `asadm` to update a roster). This mirrors the pattern of
+// a dedicated watcher that re-triggers reconciliation on external change,
+// rather than every reconcile re-deriving roster state from scratch.
+//
+// rosterWatcher implements manager.Runnable so its lifecycle is owned by the
+// controller-runtime Manager: Start is called once, in its own goroutine,
+// and is expected to block until ctx is cancelled at manager shutdown. This
+// is the same lifecycle contract as source.Channel's own producer goroutine.
+type rosterWatcher struct {
+	reconciler *SingleClusterReconciler
+
+	events chan event.GenericEvent
+
+	mu       sync.Mutex
+	lastHash string
+}
+
+var _ manager.Runnable = (*rosterWatcher)(nil)
+
+// newRosterWatcher creates a rosterWatcher for the given reconciler. It must
+// be registered with a Manager (via AddRosterWatcher) before GetSource's
+// events will ever fire.
+func newRosterWatcher(r *SingleClusterReconciler) *rosterWatcher {
+	return &rosterWatcher{
+		reconciler: r,
+		events:     make(chan event.GenericEvent, 1),
+	}
+}
+
+// GetSource returns the source.Channel that SetupWithManager should pass to
+// (*builder.Builder).WatchesRawSource so roster-change events enqueue a
+// reconcile request without an extra N×M info call per reconcile pass.
+func (w *rosterWatcher) GetSource() source.Source {
+	return &source.Channel{Source: w.events}
+}
+
+// Start polls until ctx is cancelled, satisfying manager.Runnable. The
+// Manager calls this once, in its own goroutine, and cancels ctx at
+// shutdown - so there is no separate Stop method or goroutine to leak.
+func (w *rosterWatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(rosterWatcherPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.pollOnce()
+		}
+	}
+}
+
+// pollOnce issues "namespaces" and, for every SC-enabled namespace,
+// "roster:" info commands against one reachable pod, hashes the combined
+// result, and pushes a GenericEvent onto the channel source iff the hash
+// changed since the previous poll.
+func (w *rosterWatcher) pollOnce() {
+	r := w.reconciler
+
+	hostConns, err := r.newAllHostConn()
+	if err != nil || len(hostConns) == 0 {
+		r.Log.V(1).Info("rosterWatcher: skipping poll, no reachable pod", "err", err)
+		return
+	}
+
+	ic := r.infoClient()
+
+	namespaces, err := ic.GetNamespaces(hostConns[0])
+	if err != nil {
+		r.Log.V(1).Info("rosterWatcher: failed to fetch namespaces", "err", err)
+		return
+	}
+
+	hash, err := w.hashRosterState(ic, hostConns[0], namespaces)
+	if err != nil {
+		r.Log.V(1).Info("rosterWatcher: failed to hash roster state", "err", err)
+		return
+	}
+
+	w.mu.Lock()
+	changed := w.lastHash != "" && w.lastHash != hash
+	w.lastHash = hash
+	w.mu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	r.Log.Info("rosterWatcher: detected out-of-band roster/namespace change, triggering reconcile")
+
+	w.events <- event.GenericEvent{
+		Object: r.aeroCluster,
+	}
+}
+
+// hashRosterState builds a stable digest covering every namespace's actual
+// roster state - not just whether this one pod currently sees itself as
+// SC-enabled/in-roster - so a roster change affecting a different node (a
+// pending_roster update, a node falling out of observed_nodes, ...) is
+// detected too.
+func (w *rosterWatcher) hashRosterState(
+	ic InfoClient, hostConn *deployment.HostConn, namespaces []string,
+) (string, error) {
+	sorted := append([]string(nil), namespaces...)
+	sort.Strings(sorted)
+
+	var sb strings.Builder
+
+	for _, ns := range sorted {
+		isEnabled, err := ic.IsNamespaceSCEnabled(hostConn, ns)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(ns)
+		sb.WriteString("=")
+
+		if !isEnabled {
+			sb.WriteString("non-sc;")
+			continue
+		}
+
+		roster, err := ic.GetRosterInfo(hostConn, ns)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(
+			&sb, "sc:gen=%d,roster=%s,pending=%s,observed=%s;",
+			roster.RosterGen,
+			strings.Join(roster.Roster, ","),
+			strings.Join(roster.PendingRoster, ","),
+			strings.Join(roster.ObservedNodes, ","),
+		)
+	}
+
+	sum := sha256.Sum256([]byte(sb.String()))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// watchers tracks the one rosterWatcher registered per AerospikeCluster, so
+// repeated reconciles (or repeated calls from multiple controllers) don't
+// register a new Runnable - and a new, un-primed lastHash - on every call.
+var (
+	watchersMu sync.Mutex
+	watchers   = map[types.NamespacedName]*rosterWatcher{}
+)
+
+// AddRosterWatcher lazily creates and registers, via mgr.Add, the
+// rosterWatcher for r.aeroCluster, then returns its source.Channel. The
+// real top-level AerospikeCluster controller's SetupWithManager should call
+// this once and pass the result to WatchesRawSource, the same way it wires
+// in its Pod/StatefulSet watches - SingleClusterReconciler itself is a
+// per-reconcile helper, not a reconcile.Reconciler, so it must not be
+// registered with ctrl.NewControllerManagedBy directly.
+func AddRosterWatcher(mgr ctrl.Manager, r *SingleClusterReconciler) (source.Source, error) {
+	key := types.NamespacedName{Namespace: r.aeroCluster.Namespace, Name: r.aeroCluster.Name}
+
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+
+	watcher, ok := watchers[key]
+	if !ok {
+		watcher = newRosterWatcher(r)
+
+		if err := mgr.Add(watcher); err != nil {
+			return nil, err
+		}
+
+		watchers[key] = watcher
+	}
+
+	return watcher.GetSource(), nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	asdbv1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+)
+
+// ClusterState is the slice of cluster state a SafeStopPredicate needs to
+// decide whether stopping/quiescing the node is currently safe. It is built
+// once per waitForNodeSafeStopReady call and shared across predicates so
+// they don't each re-issue the same info commands.
+type ClusterState struct {
+	Namespace string
+
+	// RosterSize is the configured roster size for Namespace.
+	RosterSize int
+	// AvailableRosterNodes is how many of those roster nodes are currently
+	// reachable and not already being stopped.
+	AvailableRosterNodes int
+
+	// HasActiveMigrations is true if the cluster as a whole is not stable
+	// (e.g. migrations are in flight); it is not specific to Namespace.
+	HasActiveMigrations bool
+
+	// RosterConverged is true if the observed roster matches the configured
+	// (pending) roster for Namespace.
+	RosterConverged bool
+
+	// Now is injected so TimeWindow is deterministic to test.
+	Now time.Time
+}
+
+// SafeStopPredicate is one gate a SafeStopPolicy evaluates, modeled on how a
+// PodDisruptionBudget admission check composes availability gates. Allowed
+// false means the stop must not proceed right now; RetryAfter hints how
+// long to wait before re-evaluating.
+type SafeStopPredicate interface {
+	Evaluate(ctx context.Context, state ClusterState) (allowed bool, reason string, retryAfter time.Duration)
+}
+
+// SafeStopPolicy composes an ordered list of predicates. The first predicate
+// to deny the stop wins; its reason and retryAfter are surfaced to the
+// caller so it can emit an event and requeue appropriately.
+type SafeStopPolicy struct {
+	Predicates []SafeStopPredicate
+}
+
+// Evaluate runs every predicate in order, short-circuiting on the first
+// denial.
+func (p *SafeStopPolicy) Evaluate(ctx context.Context, state ClusterState) (bool, string, time.Duration) {
+	for _, predicate := range p.Predicates {
+		if allowed, reason, retryAfter := predicate.Evaluate(ctx, state); !allowed {
+			return false, reason, retryAfter
+		}
+	}
+
+	return true, "", 0
+}
+
+// buildSafeStopPolicy translates spec.rackConfig.safeStopPolicy into the
+// ordered predicate list. A nil spec keeps today's implicit behaviour
+// (cluster stable + quiesce only) by returning an empty policy.
+func buildSafeStopPolicy(spec *asdbv1beta1.SafeStopPolicySpec) *SafeStopPolicy {
+	if spec == nil {
+		return &SafeStopPolicy{}
+	}
+
+	policy := &SafeStopPolicy{}
+
+	if spec.MinAvailablePerNamespace != nil {
+		policy.Predicates = append(policy.Predicates, MinAvailablePerNamespace{Min: int(*spec.MinAvailablePerNamespace)})
+	}
+
+	if spec.MaxUnavailableFraction != nil {
+		policy.Predicates = append(policy.Predicates, MaxUnavailableFraction{Fraction: *spec.MaxUnavailableFraction})
+	}
+
+	if spec.NoActiveMigrations {
+		policy.Predicates = append(policy.Predicates, NoActiveMigrations{})
+	}
+
+	if spec.AllRostersConverged {
+		policy.Predicates = append(policy.Predicates, AllRostersConverged{})
+	}
+
+	if spec.TimeWindow != nil {
+		policy.Predicates = append(policy.Predicates, TimeWindow{Start: spec.TimeWindow.Start, End: spec.TimeWindow.End})
+	}
+
+	return policy
+}
+
+// MinAvailablePerNamespace denies the stop if fewer than Min roster nodes
+// would remain available for state.Namespace. A namespace with no roster
+// (non-SC) always passes.
+type MinAvailablePerNamespace struct {
+	Min int
+}
+
+func (p MinAvailablePerNamespace) Evaluate(_ context.Context, state ClusterState) (bool, string, time.Duration) {
+	if state.RosterSize == 0 {
+		return true, "", 0
+	}
+
+	if state.AvailableRosterNodes-1 < p.Min {
+		return false, fmt.Sprintf(
+			"stopping this node would leave %d available roster nodes for namespace %s, below minAvailablePerNamespace %d",
+			state.AvailableRosterNodes-1, state.Namespace, p.Min,
+		), 30 * time.Second
+	}
+
+	return true, "", 0
+}
+
+// MaxUnavailableFraction denies the stop if the fraction of unavailable
+// roster nodes for state.Namespace would exceed Fraction (e.g. "0.25").
+type MaxUnavailableFraction struct {
+	Fraction string
+}
+
+func (p MaxUnavailableFraction) Evaluate(_ context.Context, state ClusterState) (bool, string, time.Duration) {
+	if state.RosterSize == 0 {
+		return true, "", 0
+	}
+
+	max, err := strconv.ParseFloat(p.Fraction, 64)
+	if err != nil {
+		return false, fmt.Sprintf("invalid maxUnavailableFraction %q: %v", p.Fraction, err), time.Minute
+	}
+
+	unavailable := state.RosterSize - (state.AvailableRosterNodes - 1)
+	fraction := float64(unavailable) / float64(state.RosterSize)
+
+	if fraction > max {
+		return false, fmt.Sprintf(
+			"stopping this node would make %.2f of namespace %s's roster unavailable, above maxUnavailableFraction %.2f",
+			fraction, state.Namespace, max,
+		), 30 * time.Second
+	}
+
+	return true, "", 0
+}
+
+// NoActiveMigrations denies the stop while state.HasActiveMigrations is set,
+// i.e. while the cluster is not stable.
+type NoActiveMigrations struct{}
+
+func (NoActiveMigrations) Evaluate(_ context.Context, state ClusterState) (bool, string, time.Duration) {
+	if state.HasActiveMigrations {
+		return false, fmt.Sprintf("namespace %s has active migrations", state.Namespace), 15 * time.Second
+	}
+
+	return true, "", 0
+}
+
+// AllRostersConverged denies the stop until state.Namespace's observed
+// roster matches its configured roster. A namespace with no roster (non-SC)
+// always passes.
+type AllRostersConverged struct{}
+
+func (AllRostersConverged) Evaluate(_ context.Context, state ClusterState) (bool, string, time.Duration) {
+	if state.RosterSize == 0 {
+		return true, "", 0
+	}
+
+	if !state.RosterConverged {
+		return false, fmt.Sprintf("namespace %s roster has not converged yet", state.Namespace), 15 * time.Second
+	}
+
+	return true, "", 0
+}
+
+// TimeWindow denies the stop outside a daily UTC "HH:MM"-"HH:MM" window.
+type TimeWindow struct {
+	Start string
+	End   string
+}
+
+func (w TimeWindow) Evaluate(_ context.Context, state ClusterState) (bool, string, time.Duration) {
+	now := state.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	start, err := parseHHMM(w.Start)
+	if err != nil {
+		return false, fmt.Sprintf("invalid safeStopPolicy timeWindow start %q: %v", w.Start, err), time.Hour
+	}
+
+	end, err := parseHHMM(w.End)
+	if err != nil {
+		return false, fmt.Sprintf("invalid safeStopPolicy timeWindow end %q: %v", w.End, err), time.Hour
+	}
+
+	nowMinutes := now.Hour()*60 + now.Minute()
+
+	inWindow := start <= end && nowMinutes >= start && nowMinutes < end
+	if start > end {
+		// Window wraps midnight, e.g. 22:00-02:00.
+		inWindow = nowMinutes >= start || nowMinutes < end
+	}
+
+	if !inWindow {
+		return false, fmt.Sprintf("current time is outside safe-stop window %s-%s UTC", w.Start, w.End), 10 * time.Minute
+	}
+
+	return true, "", 0
+}
+
+func parseHHMM(s string) (int, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("expected HH:MM, got %q", s)
+	}
+
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, err
+	}
+
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, err
+	}
+
+	return hour*60 + minute, nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infoparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NamespacesInfo is the decoded form of the "namespaces" info command.
+type NamespacesInfo struct {
+	Namespaces []string `json:"namespaces"`
+}
+
+// RosterInfo is the decoded form of the "roster:" info command for a single
+// namespace.
+type RosterInfo struct {
+	Namespace     string   `json:"ns"`
+	RosterGen     int64    `json:"roster_generation"`
+	Roster        []string `json:"roster"`
+	PendingRoster []string `json:"pending_roster"`
+	ObservedNodes []string `json:"observed_nodes"`
+}
+
+// NamespaceStatistics is the decoded form of the "statistics/<ns>" info
+// command. Fields the operator actually consumes are typed; anything else
+// is preserved in Extra so callers don't silently lose data the schema
+// doesn't know about yet.
+type NamespaceStatistics struct {
+	Objects                      int64             `json:"objects"`
+	SubObjects                   int64             `json:"sub_objects"`
+	TombstonesJSON               int64             `json:"tombstones,omitempty"`
+	MemoryUsedBytes              int64             `json:"memory_used_bytes"`
+	DeviceUsedBytes              int64             `json:"device_used_bytes"`
+	MigrateTxPartitionsRemaining int64             `json:"migrate_tx_partitions_remaining"`
+	MigrateRxPartitionsRemaining int64             `json:"migrate_rx_partitions_remaining"`
+	Extra                        map[string]string `json:"extra,omitempty"`
+}
+
+// ClusterStableInfo is the decoded form of the "cluster-stable" info
+// command. A non-stable cluster returns an error response instead of a key.
+type ClusterStableInfo struct {
+	ClusterKey string `json:"cluster_key"`
+}
+
+// BuildInfo is the decoded form of the "build" info command.
+type BuildInfo struct {
+	Version string `json:"version"`
+}
+
+// ServiceInfo is the decoded form of the "service" info command.
+type ServiceInfo struct {
+	Endpoints []string `json:"endpoints"`
+}
+
+func init() {
+	Register("namespaces", decodeNamespaces)
+	Register("roster:", decodeRoster)
+	Register("statistics/<ns>", decodeNamespaceStatistics)
+	Register("cluster-stable", decodeClusterStable)
+	Register("build", decodeBuild)
+	Register("service", decodeService)
+}
+
+func decodeNamespaces(raw string) (NamespacesInfo, error) {
+	return NamespacesInfo{Namespaces: splitNonEmpty(raw, ";")}, nil
+}
+
+func decodeRoster(raw string) (RosterInfo, error) {
+	fields, err := splitKV(raw, ":", "=")
+	if err != nil {
+		return RosterInfo{}, err
+	}
+
+	ns, ok := fields["ns"]
+	if !ok {
+		return RosterInfo{}, fmt.Errorf("infoparse: roster response missing ns field: %q", raw)
+	}
+
+	info := RosterInfo{
+		Namespace:     ns,
+		Roster:        splitNonEmpty(fields["roster"], ","),
+		PendingRoster: splitNonEmpty(fields["pending_roster"], ","),
+		ObservedNodes: splitNonEmpty(fields["observed_nodes"], ","),
+	}
+
+	if gen, ok := fields["roster_generation"]; ok {
+		n, err := strconv.ParseInt(gen, 10, 64)
+		if err != nil {
+			return RosterInfo{}, fmt.Errorf("infoparse: roster_generation %q: %w", gen, err)
+		}
+
+		info.RosterGen = n
+	}
+
+	return info, nil
+}
+
+func decodeNamespaceStatistics(raw string) (NamespaceStatistics, error) {
+	fields, err := splitKV(raw, ";", "=")
+	if err != nil {
+		return NamespaceStatistics{}, err
+	}
+
+	stats := NamespaceStatistics{Extra: map[string]string{}}
+
+	known := map[string]*int64{
+		"objects":                         &stats.Objects,
+		"sub_objects":                     &stats.SubObjects,
+		"tombstones":                      &stats.TombstonesJSON,
+		"memory_used_bytes":               &stats.MemoryUsedBytes,
+		"device_used_bytes":               &stats.DeviceUsedBytes,
+		"migrate_tx_partitions_remaining": &stats.MigrateTxPartitionsRemaining,
+		"migrate_rx_partitions_remaining": &stats.MigrateRxPartitionsRemaining,
+	}
+
+	for key, value := range fields {
+		target, ok := known[key]
+		if !ok {
+			stats.Extra[key] = value
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return NamespaceStatistics{}, fmt.Errorf("infoparse: %s %q: %w", key, value, err)
+		}
+
+		*target = n
+	}
+
+	return stats, nil
+}
+
+func decodeClusterStable(raw string) (ClusterStableInfo, error) {
+	key := strings.TrimSpace(raw)
+	if key == "" || strings.HasPrefix(key, "ERROR") {
+		return ClusterStableInfo{}, fmt.Errorf("infoparse: cluster is not stable: %q", raw)
+	}
+
+	return ClusterStableInfo{ClusterKey: key}, nil
+}
+
+func decodeBuild(raw string) (BuildInfo, error) {
+	version := strings.TrimSpace(raw)
+	if version == "" {
+		return BuildInfo{}, fmt.Errorf("infoparse: empty build response")
+	}
+
+	return BuildInfo{Version: version}, nil
+}
+
+func decodeService(raw string) (ServiceInfo, error) {
+	return ServiceInfo{Endpoints: splitNonEmpty(raw, ";")}, nil
+}
+
+// splitNonEmpty splits raw on sep, trimming empty segments.
+func splitNonEmpty(raw, sep string) []string {
+	if raw == "" {
+		return nil
+	}
+
+	var out []string
+
+	for _, item := range strings.Split(raw, sep) {
+		if item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+// splitKV parses a "k1=v1<del>k2=v2" response into a map, mirroring
+// controllers.ParseInfoIntoMap's delimiter/separator convention.
+func splitKV(raw, del, sep string) (map[string]string, error) {
+	m := map[string]string{}
+	if raw == "" {
+		return m, nil
+	}
+
+	for _, item := range strings.Split(raw, del) {
+		if item == "" {
+			continue
+		}
+
+		kv := strings.SplitN(item, sep, 2)
+		if len(kv) < 2 {
+			return nil, fmt.Errorf("infoparse: error parsing info item %q", item)
+		}
+
+		m[kv[0]] = kv[1]
+	}
+
+	return m, nil
+}
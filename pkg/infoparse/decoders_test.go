@@ -0,0 +1,192 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infoparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeNamespaces(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{name: "empty", raw: "", want: nil},
+		{name: "single", raw: "test", want: []string{"test"}},
+		{name: "multiple", raw: "test;bar", want: []string{"test", "bar"}},
+		{name: "trailing delimiter", raw: "test;bar;", want: []string{"test", "bar"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeNamespaces(tt.raw)
+			if err != nil {
+				t.Fatalf("decodeNamespaces(%q): unexpected error: %v", tt.raw, err)
+			}
+
+			if !reflect.DeepEqual(got.Namespaces, tt.want) {
+				t.Errorf("decodeNamespaces(%q) = %v, want %v", tt.raw, got.Namespaces, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRoster(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    RosterInfo
+		wantErr bool
+	}{
+		{
+			name: "full",
+			raw:  "ns=test:roster_generation=3:roster=A,B,C:pending_roster=A,B,C:observed_nodes=A,B",
+			want: RosterInfo{
+				Namespace:     "test",
+				RosterGen:     3,
+				Roster:        []string{"A", "B", "C"},
+				PendingRoster: []string{"A", "B", "C"},
+				ObservedNodes: []string{"A", "B"},
+			},
+		},
+		{
+			name: "missing optional fields",
+			raw:  "ns=test:roster=A",
+			want: RosterInfo{Namespace: "test", Roster: []string{"A"}},
+		},
+		{name: "missing ns field", raw: "roster=A", wantErr: true},
+		{name: "non-numeric roster_generation", raw: "ns=test:roster_generation=abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeRoster(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("decodeRoster(%q): expected error, got none", tt.raw)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("decodeRoster(%q): unexpected error: %v", tt.raw, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeRoster(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeNamespaceStatistics(t *testing.T) {
+	raw := "objects=10;sub_objects=2;memory_used_bytes=1024;device_used_bytes=2048;" +
+		"migrate_tx_partitions_remaining=0;migrate_rx_partitions_remaining=0;some_future_stat=7"
+
+	got, err := decodeNamespaceStatistics(raw)
+	if err != nil {
+		t.Fatalf("decodeNamespaceStatistics: unexpected error: %v", err)
+	}
+
+	want := NamespaceStatistics{
+		Objects:         10,
+		SubObjects:      2,
+		MemoryUsedBytes: 1024,
+		DeviceUsedBytes: 2048,
+		Extra:           map[string]string{"some_future_stat": "7"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decodeNamespaceStatistics = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeNamespaceStatisticsBadNumber(t *testing.T) {
+	if _, err := decodeNamespaceStatistics("objects=notanumber"); err == nil {
+		t.Fatal("expected error for non-numeric objects field")
+	}
+}
+
+func TestDecodeClusterStable(t *testing.T) {
+	if _, err := decodeClusterStable(""); err == nil {
+		t.Fatal("expected error for empty response")
+	}
+
+	if _, err := decodeClusterStable("ERROR::not-stable"); err == nil {
+		t.Fatal("expected error for ERROR response")
+	}
+
+	got, err := decodeClusterStable("abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.ClusterKey != "abc123" {
+		t.Errorf("ClusterKey = %q, want %q", got.ClusterKey, "abc123")
+	}
+}
+
+func TestDecodeBuildAndService(t *testing.T) {
+	build, err := decodeBuild(" 6.2.0.1 \n")
+	if err != nil {
+		t.Fatalf("decodeBuild: unexpected error: %v", err)
+	}
+
+	if build.Version != "6.2.0.1" {
+		t.Errorf("Version = %q, want %q", build.Version, "6.2.0.1")
+	}
+
+	if _, err := decodeBuild(""); err == nil {
+		t.Fatal("decodeBuild: expected error for empty response")
+	}
+
+	service, err := decodeService("1.2.3.4:3000;5.6.7.8:3000")
+	if err != nil {
+		t.Fatalf("decodeService: unexpected error: %v", err)
+	}
+
+	want := []string{"1.2.3.4:3000", "5.6.7.8:3000"}
+	if !reflect.DeepEqual(service.Endpoints, want) {
+		t.Errorf("Endpoints = %v, want %v", service.Endpoints, want)
+	}
+}
+
+func TestDecodeRegistryRoundTrip(t *testing.T) {
+	if !IsRegistered("roster:") {
+		t.Fatal(`"roster:" should be registered by init()`)
+	}
+
+	got, err := Decode[RosterInfo]("roster:", "ns=test:roster=A,B")
+	if err != nil {
+		t.Fatalf("Decode: unexpected error: %v", err)
+	}
+
+	if got.Namespace != "test" {
+		t.Errorf("Namespace = %q, want %q", got.Namespace, "test")
+	}
+}
+
+func TestDecodeUnknownCommand(t *testing.T) {
+	if _, err := Decode[RosterInfo]("not-a-command", ""); err == nil {
+		t.Fatal("expected error for unregistered command")
+	}
+}
+
+func TestDecodeTypeMismatch(t *testing.T) {
+	if _, err := Decode[NamespacesInfo]("roster:", "ns=test"); err == nil {
+		t.Fatal(`expected error decoding "roster:" as NamespacesInfo`)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2018 The aerospike-operator Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package infoparse decodes Aerospike info-command responses into
+// strongly-typed, JSON-taggable structs instead of the
+// controllers.ParseInfoIntoMap map[string]string, so callers stop re-parsing
+// values with ad-hoc string splits and the result can be surfaced directly
+// in AerospikeCluster status.
+package infoparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// decodeFunc is the type-erased form of a registered decoder: it accepts
+// the raw info response body and returns the decoded value as an any.
+type decodeFunc func(raw string) (interface{}, error)
+
+// registry is keyed by the info command name (e.g. "roster:"), not by Go
+// type, so Decode[T] actually dispatches on the command the raw response
+// came from rather than trusting the caller's type parameter alone.
+var registry = map[string]decodeFunc{}
+
+// commandTypes maps each registered command to the Go type its decoder
+// produces, so Decode[T] can reject a command/T mismatch instead of
+// silently returning whatever the command's real decoder happened to
+// produce reinterpreted as T.
+var commandTypes = map[string]reflect.Type{}
+
+// Register associates an info command with a decoder that produces T.
+// Subsequent calls to Decode[T](command, raw) will use decode to parse raw
+// responses for that command. Register is expected to be called from
+// package init(), one command per supported type - see decoders.go.
+func Register[T any](command string, decode func(raw string) (T, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	registry[command] = func(raw string) (interface{}, error) {
+		return decode(raw)
+	}
+	commandTypes[command] = t
+}
+
+// Decode parses raw, which must be the response to the given info command,
+// using the decoder registered for that command. It returns an error if no
+// decoder is registered for command, if T doesn't match the type command
+// was registered with, or if the decoder itself fails to parse raw (missing
+// fields, bad numerics, etc).
+func Decode[T any](command, raw string) (T, error) {
+	var zero T
+
+	wantType := reflect.TypeOf((*T)(nil)).Elem()
+
+	gotType, ok := commandTypes[command]
+	if !ok {
+		return zero, fmt.Errorf("infoparse: no decoder registered for command %q", command)
+	}
+
+	if gotType != wantType {
+		return zero, fmt.Errorf(
+			"infoparse: command %q decodes to %s, not %s", command, gotType, wantType,
+		)
+	}
+
+	v, err := registry[command](raw)
+	if err != nil {
+		return zero, err
+	}
+
+	typed, ok := v.(T)
+	if !ok {
+		return zero, fmt.Errorf("infoparse: decoder for command %q returned %T", command, v)
+	}
+
+	return typed, nil
+}
+
+// IsRegistered reports whether command has a decoder registered.
+func IsRegistered(command string) bool {
+	_, ok := commandTypes[command]
+	return ok
+}